@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// organizationIntegrationDataSource discovers integrations that are already
+// installed in an organization, so users can adopt them with
+// sentry_organization_integration_configuration without manually importing.
+// name narrows the result to a single integration; omitting it lists every
+// installed integration for provider_key via the integrations attribute.
+type organizationIntegrationDataSource struct {
+	client *sentry.Client
+}
+
+type organizationIntegrationDataSourceModel struct {
+	Id            types.String                             `tfsdk:"id"`
+	Organization  types.String                             `tfsdk:"organization"`
+	ProviderKey   types.String                             `tfsdk:"provider_key"`
+	Name          types.String                             `tfsdk:"name"`
+	IntegrationId types.String                             `tfsdk:"integration_id"`
+	Integrations  []organizationIntegrationDataSourceEntry `tfsdk:"integrations"`
+}
+
+type organizationIntegrationDataSourceEntry struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func NewOrganizationIntegrationDataSource() *organizationIntegrationDataSource {
+	return &organizationIntegrationDataSource{}
+}
+
+func (d *organizationIntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sentry.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sentry.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *organizationIntegrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_integration"
+}
+
+func (d *organizationIntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up organization integrations that are already installed, by provider and, optionally, name. Omit `name` to list every installed integration for `provider_key` via `integrations`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the installed organization integration. Only set when exactly one integration matches.",
+				Computed:    true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "The slug of the organization.",
+				Required:    true,
+			},
+			"provider_key": schema.StringAttribute{
+				Description: "Specific integration provider to filter by such as `slack`. See [the list of supported providers](https://docs.sentry.io/product/integrations/).",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The display name of the installed integration, e.g. the Slack workspace name. If omitted, every integration for provider_key is returned via `integrations`.",
+				Optional:    true,
+			},
+			"integration_id": schema.StringAttribute{
+				Description: "The ID of the installed organization integration. Only set when exactly one integration matches.",
+				Computed:    true,
+			},
+			"integrations":schema.ListNestedAttribute{
+				Description: "Every installed integration for provider_key, further narrowed by name if given.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the installed organization integration.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The display name of the installed integration.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *organizationIntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data organizationIntegrationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var matchedIntegrations []*sentry.OrganizationIntegration
+	params := &sentry.ListOrganizationIntegrationsParams{
+		ListCursorParams: sentry.ListCursorParams{},
+		ProviderKey:      data.ProviderKey.ValueString(),
+	}
+
+	for {
+		integrations, apiResp, err := d.client.OrganizationIntegrations.List(ctx, data.Organization.ValueString(), params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization integrations, got error: %s", err))
+			return
+		}
+
+		for _, integration := range integrations {
+			if data.Name.IsNull() || integration.Name == data.Name.ValueString() {
+				matchedIntegrations = append(matchedIntegrations, integration)
+			}
+		}
+
+		if apiResp.Cursor == "" {
+			break
+		}
+		params.ListCursorParams.Cursor = apiResp.Cursor
+	}
+
+	if len(matchedIntegrations) == 0 {
+		resp.Diagnostics.AddError("Not Found", "No matching organization integrations found")
+		return
+	}
+
+	data.Integrations = make([]organizationIntegrationDataSourceEntry, 0, len(matchedIntegrations))
+	for _, integration := range matchedIntegrations {
+		data.Integrations = append(data.Integrations, organizationIntegrationDataSourceEntry{
+			Id:   types.StringValue(integration.ID),
+			Name: types.StringValue(integration.Name),
+		})
+	}
+
+	// id/integration_id are single-match convenience fields: only
+	// populated when there's exactly one result to be unambiguous about.
+	// Callers that expect multiple matches (e.g. no name filter) should
+	// use integrations instead.
+	if len(matchedIntegrations) == 1 {
+		data.Id = types.StringValue(matchedIntegrations[0].ID)
+		data.IntegrationId = types.StringValue(matchedIntegrations[0].ID)
+	} else {
+		if !data.Name.IsNull() {
+			resp.Diagnostics.AddError("Not Unique", "More than one matching organization integration found")
+			return
+		}
+		data.Id = types.StringNull()
+		data.IntegrationId = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
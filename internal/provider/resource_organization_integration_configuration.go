@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,12 +21,12 @@ type organizationIntegrationConfigurationResource struct {
 }
 
 type organizationIntegrationConfigurationResourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	Organization types.String `tfsdk:"organization"`
-	ProviderKey  types.String `tfsdk:"provider_key"`
-	IsFragment   types.Bool   `tfsdk:"is_fragment"`
-	Name         types.String `tfsdk:"name"`
-	ConfigData   types.String `tfsdk:"configData"`
+	Id           types.String         `tfsdk:"id"`
+	Organization types.String         `tfsdk:"organization"`
+	ProviderKey  types.String         `tfsdk:"provider_key"`
+	IsFragment   types.Bool           `tfsdk:"is_fragment"`
+	Name         types.String         `tfsdk:"name"`
+	Config       jsontypes.Normalized `tfsdk:"config"`
 }
 
 func NewOrganizationIntegrationConfigurationResource() *organizationIntegrationConfigurationResource {
@@ -77,13 +80,11 @@ func (r *organizationIntegrationConfigurationResource) Schema(ctx context.Contex
 				Required:    true,
 			},
 			"is_fragment": schema.BoolAttribute{
-				Description: "Whether the integration configuration is a fragment. Terraform will attempt to merge the provided configuration with the existing configuration if set to true and manage the partial configuration separately in state.",
+				Description: "Whether the integration configuration is a fragment. Terraform will attempt to merge the provided configuration with the existing configuration if set to true and manage the partial configuration separately in state. On delete, only the fragment's own keys are removed from the remote configuration.",
 				Optional:    true,
 			},
-			"config": schema.MapAttribute{
-				ElementType: types.MapType{
-					ElemType: types.StringType,
-				},
+			"config": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
 				Description: "The organization integration configuration in JSON format.",
 				Required:    true,
 			},
@@ -91,39 +92,94 @@ func (r *organizationIntegrationConfigurationResource) Schema(ctx context.Contex
 	}
 }
 
-func (r *organizationIntegrationConfigurationResourceModel) Fill(organizationSlug string, configData []byte, d sentry.OrganizationIntegration) error {
+func (r *organizationIntegrationConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data organizationIntegrationConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProviderKey.IsUnknown() || data.Config.IsUnknown() || data.Config.IsNull() {
+		return
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Config.ValueString()), &config); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config"),
+			"Invalid Config",
+			fmt.Sprintf("config must be valid JSON: %s", err.Error()),
+		)
+		return
+	}
+
+	for _, msg := range validateProviderConfig(data.ProviderKey.ValueString(), config) {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Integration Config", msg)
+	}
+}
+
+func (r *organizationIntegrationConfigurationResourceModel) Fill(organizationSlug string, d sentry.OrganizationIntegration, configData map[string]interface{}) error {
+	encoded, err := json.Marshal(configData)
+	if err != nil {
+		return err
+	}
+
 	r.Id = types.StringValue(d.ID)
 	r.Organization = types.StringValue(organizationSlug)
 	r.ProviderKey = types.StringValue(d.Provider.Key)
 	r.Name = types.StringValue(d.Name)
-	r.ConfigData = types.StringValue(string(configData))
+	r.Config = jsontypes.NewNormalizedValue(string(encoded))
 
 	return nil
 }
 
-func (r *organizationIntegrationConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data organizationIntegrationConfigurationResourceModel
+// deepMergeConfig merges overlay on top of base: map keys are merged
+// recursively, any other value (including arrays) in overlay replaces the
+// value in base outright.
+func deepMergeConfig(base, overlay map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+	for k, overlayValue := range overlay {
+		baseValue, ok := merged[k]
+		if !ok {
+			merged[k] = overlayValue
+			continue
+		}
+
+		baseMap, baseIsMap := baseValue.(map[string]interface{})
+		overlayMap, overlayIsMap := overlayValue.(map[string]interface{})
+		if baseIsMap && overlayIsMap {
+			merged[k] = deepMergeConfig(baseMap, overlayMap)
+		} else {
+			merged[k] = overlayValue
+		}
 	}
 
+	return merged
+}
+
+// findIntegrationByName looks up the single organization integration with the
+// given provider key and display name. It is used on Create, before the
+// resource's id is known.
+func (r *organizationIntegrationConfigurationResource) findIntegrationByName(ctx context.Context, organization, providerKey, name string) (*sentry.OrganizationIntegration, error) {
 	var matchedIntegrations []*sentry.OrganizationIntegration
 	params := &sentry.ListOrganizationIntegrationsParams{
 		ListCursorParams: sentry.ListCursorParams{},
-		ProviderKey:      data.ProviderKey.ValueString(),
+		ProviderKey:      providerKey,
 	}
 
 	for {
-		integrations, apiResp, err := r.client.OrganizationIntegrations.List(ctx, data.Organization.ValueString(), params)
+		integrations, apiResp, err := r.client.OrganizationIntegrations.List(ctx, organization, params)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization integrations, got error: %s", err))
-			return
+			return nil, fmt.Errorf("unable to read organization integrations: %w", err)
 		}
 
 		for _, integration := range integrations {
-			if integration.Name == data.Name.ValueString() {
+			if integration.Name == name {
 				matchedIntegrations = append(matchedIntegrations, integration)
 			}
 		}
@@ -135,24 +191,88 @@ func (r *organizationIntegrationConfigurationResource) Read(ctx context.Context,
 	}
 
 	if len(matchedIntegrations) == 0 {
-		resp.Diagnostics.AddError("Not Found", "No matching organization integrations found")
-		return
+		return nil, fmt.Errorf("no matching organization integrations found")
 	} else if len(matchedIntegrations) > 1 {
-		resp.Diagnostics.AddError("Not Unique", "More than one matching organization integration found")
+		return nil, fmt.Errorf("more than one matching organization integration found")
+	}
+
+	return matchedIntegrations[0], nil
+}
+
+// findIntegrationByID looks up the organization integration by its id, which
+// is known once the resource has been created or imported.
+func (r *organizationIntegrationConfigurationResource) findIntegrationByID(ctx context.Context, organization, providerKey, id string) (*sentry.OrganizationIntegration, error) {
+	params := &sentry.ListOrganizationIntegrationsParams{
+		ListCursorParams: sentry.ListCursorParams{},
+		ProviderKey:      providerKey,
+	}
+
+	for {
+		integrations, apiResp, err := r.client.OrganizationIntegrations.List(ctx, organization, params)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read organization integrations: %w", err)
+		}
+
+		for _, integration := range integrations {
+			if integration.ID == id {
+				return integration, nil
+			}
+		}
+
+		if apiResp.Cursor == "" {
+			break
+		}
+		params.ListCursorParams.Cursor = apiResp.Cursor
+	}
+
+	return nil, fmt.Errorf("no organization integration found with id %q", id)
+}
+
+func (r *organizationIntegrationConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationIntegrationConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	configData, err := json.Marshal(matchedIntegrations[0].ConfigData)
+	integration, err := r.findIntegrationByID(ctx, data.Organization.ValueString(), data.ProviderKey.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Failed to convert ConfigData to JSON: %s", err.Error()))
+		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 
-	if err := data.Fill(data.Organization.ValueString(), configData, *matchedIntegrations[0]); err != nil {
+	remoteConfig := map[string]interface{}(integration.ConfigData)
+
+	// Project only the keys the user originally supplied back into state,
+	// whether or not this is a fragment. Sentry commonly normalizes or adds
+	// keys to ConfigData that the user never set (and, for fragments, other
+	// fragments or the Sentry UI may own the rest), so storing the full
+	// remote config verbatim would produce a perpetual diff against the
+	// user's own jsonencode(...) input.
+	configToStore := remoteConfig
+	if !data.Config.IsNull() && !data.Config.IsUnknown() {
+		var priorConfig map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Config.ValueString()), &priorConfig); err != nil {
+			resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Failed to parse prior config as JSON: %s", err.Error()))
+			return
+		}
+
+		projected := make(map[string]interface{}, len(priorConfig))
+		for k := range priorConfig {
+			if v, ok := remoteConfig[k]; ok {
+				projected[k] = v
+			}
+		}
+		configToStore = projected
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), *integration, configToStore); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling organization integration: %s", err.Error()))
 		return
 	}
 
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *organizationIntegrationConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -163,18 +283,129 @@ func (r *organizationIntegrationConfigurationResource) Create(ctx context.Contex
 		return
 	}
 
-	configData := map[string]interface{}{}
-	if err := json.Unmarshal([]byte(data.ConfigData.ValueString()), &configData); err != nil {
-		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Failed to convert ConfigData to JSON: %s", err.Error()))
+	planConfig := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(data.Config.ValueString()), &planConfig); err != nil {
+		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Failed to convert config to JSON: %s", err.Error()))
+		return
+	}
+
+	integration, err := r.findIntegrationByName(ctx, data.Organization.ValueString(), data.ProviderKey.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	configToSend := planConfig
+	if data.IsFragment.ValueBool() {
+		configToSend = deepMergeConfig(integration.ConfigData, planConfig)
+	}
+
+	params := sentry.IntegrationConfigData(configToSend)
+	if _, err := r.client.OrganizationIntegrations.UpdateConfig(ctx, data.Organization.ValueString(), integration.ID, &params); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization integration configuration, got error: %s", err))
+		return
+	}
+
+	if err := data.Fill(data.Organization.ValueString(), *integration, planConfig); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling organization integration: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationIntegrationConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data organizationIntegrationConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planConfig := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(data.Config.ValueString()), &planConfig); err != nil {
+		resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Failed to convert config to JSON: %s", err.Error()))
+		return
+	}
+
+	integration, err := r.findIntegrationByID(ctx, data.Organization.ValueString(), data.ProviderKey.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	configToSend := planConfig
+	if data.IsFragment.ValueBool() {
+		configToSend = deepMergeConfig(integration.ConfigData, planConfig)
+	}
+
+	params := sentry.IntegrationConfigData(configToSend)
+	if _, err := r.client.OrganizationIntegrations.UpdateConfig(ctx, data.Organization.ValueString(), integration.ID, &params); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization integration configuration, got error: %s", err))
 		return
 	}
 
-	_, err := r.client.OrganizationIntegrations.UpdateConfig(ctx, data.Organization.ValueString(), data.Id.ValueString(), configData)
+	if err := data.Fill(data.Organization.ValueString(), *integration, planConfig); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error filling organization integration: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
+func (r *organizationIntegrationConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data organizationIntegrationConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := r.findIntegrationByID(ctx, data.Organization.ValueString(), data.ProviderKey.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization integration, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	configToSend := map[string]interface{}{}
+	if data.IsFragment.ValueBool() {
+		var fragmentConfig map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Config.ValueString()), &fragmentConfig); err != nil {
+			resp.Diagnostics.AddError("Conversion Error", fmt.Sprintf("Failed to convert config to JSON: %s", err.Error()))
+			return
+		}
+
+		// Only remove this fragment's own keys, leaving the rest of the
+		// remote configuration (managed by other fragments) untouched.
+		remaining := make(map[string]interface{}, len(integration.ConfigData))
+		for k, v := range integration.ConfigData {
+			remaining[k] = v
+		}
+		for k := range fragmentConfig {
+			delete(remaining, k)
+		}
+		configToSend = remaining
+	}
+
+	params := sentry.IntegrationConfigData(configToSend)
+	if _, err := r.client.OrganizationIntegrations.UpdateConfig(ctx, data.Organization.ValueString(), integration.ID, &params); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete organization integration configuration, got error: %s", err))
+		return
+	}
+}
+
+func (r *organizationIntegrationConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/provider_key/integration_id. Got: %q", req.ID),
+		)
 		return
 	}
 
-	resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_key"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
 }
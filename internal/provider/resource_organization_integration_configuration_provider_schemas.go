@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// providerAllowedKeys lists every top-level config key each provider's
+// Sentry integration actually understands. validateProviderConfig rejects
+// anything outside this set so a typo like "pagerduty_services" (instead of
+// "service_table") is caught at plan time instead of silently reaching
+// Sentry's API.
+var providerAllowedKeys = map[string]map[string]bool{
+	"slack":     {"channel": true},
+	"msteams":   {"channel": true},
+	"pagerduty": {"service_table": true},
+	"opsgenie":  {"team_table": true},
+	"github":    {"repo": true},
+	"jira":      {"project": true},
+}
+
+// validateProviderConfig dispatches on the integration's provider_key and
+// checks that config contains the keys that provider's Sentry integration
+// actually understands, catching typos like "pagerduty_services" or a
+// malformed service table at plan time instead of at apply time.
+func validateProviderConfig(providerKey string, config map[string]interface{}) []string {
+	errs := rejectUnknownKeys(providerKey, config)
+
+	switch providerKey {
+	case "slack":
+		errs = append(errs, validateSlackConfig(config)...)
+	case "pagerduty":
+		errs = append(errs, validatePagerDutyConfig(config)...)
+	case "msteams":
+		errs = append(errs, validateMSTeamsConfig(config)...)
+	case "opsgenie":
+		errs = append(errs, validateOpsgenieConfig(config)...)
+	case "github":
+		errs = append(errs, validateGitHubConfig(config)...)
+	case "jira":
+		errs = append(errs, validateJiraConfig(config)...)
+	default:
+		// Unknown/unsupported providers are passed through untouched; Sentry
+		// remains the source of truth for validation.
+	}
+
+	return errs
+}
+
+// rejectUnknownKeys flags any config key that isn't in providerAllowedKeys
+// for providerKey. Providers with no known key set (i.e. not yet modeled
+// here) are passed through untouched.
+func rejectUnknownKeys(providerKey string, config map[string]interface{}) []string {
+	allowed, ok := providerAllowedKeys[providerKey]
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, k := range keys {
+		if !allowed[k] {
+			errs = append(errs, fmt.Sprintf("unknown key %q for provider %q", k, providerKey))
+		}
+	}
+
+	return errs
+}
+
+// slackChannelPattern is Slack's own naming rule: lowercase letters,
+// numbers, hyphens and underscores, no spaces.
+var slackChannelPattern = regexp.MustCompile(`^#[a-z0-9_-]+$`)
+
+// normalizeSlackChannel lowercases a channel name, replaces spaces with
+// hyphens, and ensures a leading "#", so "General Alerts" and "#general-alerts"
+// are recognized as the same channel.
+func normalizeSlackChannel(channel string) string {
+	normalized := strings.ToLower(strings.TrimSpace(channel))
+	normalized = strings.ReplaceAll(normalized, " ", "-")
+	if !strings.HasPrefix(normalized, "#") {
+		normalized = "#" + normalized
+	}
+	return normalized
+}
+
+func validateSlackConfig(config map[string]interface{}) []string {
+	var errs []string
+
+	if raw, ok := config["channel"]; ok {
+		channel, isString := raw.(string)
+		if !isString {
+			errs = append(errs, `"channel" must be a string`)
+		} else if channel == "" {
+			errs = append(errs, `"channel" must not be empty`)
+		} else if normalized := normalizeSlackChannel(channel); !slackChannelPattern.MatchString(normalized) {
+			errs = append(errs, fmt.Sprintf(`"channel" %q is not a valid Slack channel name; expected something like %q`, channel, normalized))
+		} else if normalized != channel {
+			errs = append(errs, fmt.Sprintf(`"channel" %q does not match Sentry's normalized channel name %q`, channel, normalized))
+		}
+	}
+
+	return errs
+}
+
+func validateMSTeamsConfig(config map[string]interface{}) []string {
+	var errs []string
+
+	if raw, ok := config["channel"]; ok {
+		if _, isString := raw.(string); !isString {
+			errs = append(errs, `"channel" must be a string`)
+		}
+	}
+
+	return errs
+}
+
+func validatePagerDutyConfig(config map[string]interface{}) []string {
+	var errs []string
+
+	raw, ok := config["service_table"]
+	if !ok {
+		return errs
+	}
+
+	rows, isSlice := raw.([]interface{})
+	if !isSlice {
+		return []string{`"service_table" must be a list of objects`}
+	}
+
+	for i, rowRaw := range rows {
+		row, isMap := rowRaw.(map[string]interface{})
+		if !isMap {
+			errs = append(errs, fmt.Sprintf(`"service_table[%d]" must be an object`, i))
+			continue
+		}
+
+		if _, ok := row["service"]; !ok {
+			errs = append(errs, fmt.Sprintf(`"service_table[%d]" is missing required key "service"`, i))
+		}
+		if _, ok := row["integration_key"]; !ok {
+			errs = append(errs, fmt.Sprintf(`"service_table[%d]" is missing required key "integration_key"`, i))
+		}
+	}
+
+	return errs
+}
+
+var opsgeniePriorities = map[string]bool{
+	"P1": true, "P2": true, "P3": true, "P4": true, "P5": true,
+}
+
+func validateOpsgenieConfig(config map[string]interface{}) []string {
+	var errs []string
+
+	raw, ok := config["team_table"]
+	if !ok {
+		return errs
+	}
+
+	rows, isSlice := raw.([]interface{})
+	if !isSlice {
+		return []string{`"team_table" must be a list of objects`}
+	}
+
+	for i, rowRaw := range rows {
+		row, isMap := rowRaw.(map[string]interface{})
+		if !isMap {
+			errs = append(errs, fmt.Sprintf(`"team_table[%d]" must be an object`, i))
+			continue
+		}
+
+		if _, ok := row["id"]; !ok {
+			errs = append(errs, fmt.Sprintf(`"team_table[%d]" is missing required key "id"`, i))
+		}
+
+		if priorityRaw, ok := row["priority"]; ok {
+			priority, isString := priorityRaw.(string)
+			if !isString || !opsgeniePriorities[priority] {
+				errs = append(errs, fmt.Sprintf(`"team_table[%d].priority" must be one of P1, P2, P3, P4, P5`, i))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateGitHubConfig(config map[string]interface{}) []string {
+	var errs []string
+
+	if raw, ok := config["repo"]; ok {
+		if _, isString := raw.(string); !isString {
+			errs = append(errs, `"repo" must be a string`)
+		}
+	}
+
+	return errs
+}
+
+func validateJiraConfig(config map[string]interface{}) []string {
+	var errs []string
+
+	if raw, ok := config["project"]; ok {
+		if _, isString := raw.(string); !isString {
+			errs = append(errs, `"project" must be a string`)
+		}
+	}
+
+	return errs
+}
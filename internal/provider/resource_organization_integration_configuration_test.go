@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/teamsnap/terraform-provider-sentry/internal/acctest"
+)
+
+// TestAccOrganizationIntegrationConfigurationResource_fragment drives
+// organizationIntegrationConfigurationResource directly through its
+// Create/Read/Update/Delete/ImportState methods against a recorded cassette,
+// covering the is_fragment merge on Create/Update, the partial-key
+// projection on Read, and the partial-delete of only the fragment's own
+// keys on Delete.
+func TestAccOrganizationIntegrationConfigurationResource_fragment(t *testing.T) {
+	ctx := context.Background()
+
+	client, stop, err := acctest.NewClient(t.Name())
+	if err != nil {
+		t.Fatalf("unable to create VCR client: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := stop(); err != nil {
+			t.Errorf("unable to stop VCR recorder: %s", err)
+		}
+	})
+
+	r := &organizationIntegrationConfigurationResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+	sch := schemaResp.Schema
+
+	// Create: merges the plan's fragment on top of the integration's
+	// existing remote config, and stores back only the key the user
+	// supplied.
+	plan := tfsdk.Plan{Schema: sch}
+	if diags := plan.Set(ctx, &organizationIntegrationConfigurationResourceModel{
+		Id:           types.StringUnknown(),
+		Organization: types.StringValue("redacted-org"),
+		ProviderKey:  types.StringValue("slack"),
+		Name:         types.StringValue("Acceptance Test Workspace"),
+		IsFragment:   types.BoolValue(true),
+		Config:       jsontypes.NewNormalizedValue(`{"channel":"#alerts-critical"}`),
+	}); diags.HasError() {
+		t.Fatalf("unable to set create plan: %v", diags)
+	}
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: sch}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created organizationIntegrationConfigurationResourceModel
+	if diags := createResp.State.Get(ctx, &created); diags.HasError() {
+		t.Fatalf("unable to read create state: %v", diags)
+	}
+	if created.Id.ValueString() != "123456" {
+		t.Fatalf("expected id %q, got %q", "123456", created.Id.ValueString())
+	}
+	if created.Config.ValueString() != `{"channel":"#alerts-critical"}` {
+		t.Fatalf("expected only the user-supplied key to be stored, got %s", created.Config.ValueString())
+	}
+
+	// Read: projects only the previously known key back into state, even
+	// though the remote config also carries "notify_on" managed elsewhere.
+	readState := tfsdk.State{Schema: sch}
+	if diags := readState.Set(ctx, &created); diags.HasError() {
+		t.Fatalf("unable to set read state: %v", diags)
+	}
+
+	readResp := resource.ReadResponse{State: tfsdk.State{Schema: sch}}
+	r.Read(ctx, resource.ReadRequest{State: readState}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var read organizationIntegrationConfigurationResourceModel
+	if diags := readResp.State.Get(ctx, &read); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	if read.Config.ValueString() != `{"channel":"#alerts-critical"}` {
+		t.Fatalf("expected read to project only the fragment's own key, got %s", read.Config.ValueString())
+	}
+
+	// Update: merges a new fragment value on top of the now-updated remote
+	// config.
+	updatePlan := tfsdk.Plan{Schema: sch}
+	if diags := updatePlan.Set(ctx, &organizationIntegrationConfigurationResourceModel{
+		Id:           read.Id,
+		Organization: read.Organization,
+		ProviderKey:  read.ProviderKey,
+		Name:         read.Name,
+		IsFragment:   types.BoolValue(true),
+		Config:       jsontypes.NewNormalizedValue(`{"channel":"#alerts-urgent"}`),
+	}); diags.HasError() {
+		t.Fatalf("unable to set update plan: %v", diags)
+	}
+
+	updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: sch}}
+	r.Update(ctx, resource.UpdateRequest{Plan: updatePlan, State: readState}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	var updated organizationIntegrationConfigurationResourceModel
+	if diags := updateResp.State.Get(ctx, &updated); diags.HasError() {
+		t.Fatalf("unable to read update state: %v", diags)
+	}
+	if updated.Config.ValueString() != `{"channel":"#alerts-urgent"}` {
+		t.Fatalf("expected updated fragment value, got %s", updated.Config.ValueString())
+	}
+
+	// Delete: only removes the fragment's own key ("channel"), leaving
+	// "notify_on" untouched on the remote integration.
+	deleteState := tfsdk.State{Schema: sch}
+	if diags := deleteState.Set(ctx, &updated); diags.HasError() {
+		t.Fatalf("unable to set delete state: %v", diags)
+	}
+
+	deleteResp := resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: deleteState}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+	}
+
+	// Import: organization/provider_key/integration_id is enough for
+	// ImportState to reconstruct the attributes Read needs to refresh the
+	// rest of state.
+	importResp := resource.ImportStateResponse{State: tfsdk.State{Schema: sch}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "redacted-org/slack/123456"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+	}
+
+	var imported organizationIntegrationConfigurationResourceModel
+	if diags := importResp.State.Get(ctx, &imported); diags.HasError() {
+		t.Fatalf("unable to read import state: %v", diags)
+	}
+	if imported.Id.ValueString() != "123456" || imported.ProviderKey.ValueString() != "slack" || imported.Organization.ValueString() != "redacted-org" {
+		t.Fatalf("unexpected imported state: %+v", imported)
+	}
+}
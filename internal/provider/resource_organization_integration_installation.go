@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+)
+
+// organizationIntegrationInstallationResource manages the Terraform lifecycle
+// of an organization integration that has already been installed through
+// Sentry's interactive OAuth flow (Slack, GitHub, etc. have no non-interactive
+// install API). Create adopts the installation by provider_key/name instead
+// of performing the install itself, since go-sentry's OrganizationIntegrations
+// service only exposes List and UpdateConfig, and there's no uninstall
+// counterpart. Delete clears its config only when reset_config_on_destroy is
+// set, since integration_id is also commonly targeted by one or more
+// `sentry_organization_integration_configuration` resources. Use
+// `sentry_organization_integration_configuration` to manage config after
+// this resource has adopted the installation.
+type organizationIntegrationInstallationResource struct {
+	client *sentry.Client
+}
+
+type organizationIntegrationInstallationResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Organization         types.String `tfsdk:"organization"`
+	ProviderKey          types.String `tfsdk:"provider_key"`
+	Name                 types.String `tfsdk:"name"`
+	IntegrationId        types.String `tfsdk:"integration_id"`
+	ResetConfigOnDestroy types.Bool   `tfsdk:"reset_config_on_destroy"`
+}
+
+func NewOrganizationIntegrationInstallationResource() *organizationIntegrationInstallationResource {
+	return &organizationIntegrationInstallationResource{}
+}
+
+func (r *organizationIntegrationInstallationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sentry.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sentry.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationIntegrationInstallationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_integration_installation"
+}
+
+func (r *organizationIntegrationInstallationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adopts an organization integration that has already been installed via Sentry's interactive OAuth flow, so it can be referenced from `sentry_organization_integration_configuration`. Sentry has no non-interactive uninstall API for OAuth-based providers, so on destroy this resource leaves the installation and its config alone unless `reset_config_on_destroy` is set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				Description: "The slug of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_key": schema.StringAttribute{
+				Description: "Specific integration provider to filter by such as `slack`. See [the list of supported providers](https://docs.sentry.io/product/integrations/).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The display name of the already-installed integration, e.g. the Slack workspace name. Use the `sentry_organization_integration` data source to discover it.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Description: "The ID of the installed organization integration. Reference this from `sentry_organization_integration_configuration`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reset_config_on_destroy": schema.BoolAttribute{
+				Description: "Whether to clear the integration's entire remote config on destroy. Defaults to false, since the same integration_id is often also targeted by one or more sentry_organization_integration_configuration resources, and clearing the whole config would clobber keys those resources own. Only set this to true if this resource is the sole manager of the integration's config.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *organizationIntegrationInstallationResource) findIntegration(ctx context.Context, organization, providerKey, name string) (*sentry.OrganizationIntegration, error) {
+	params := &sentry.ListOrganizationIntegrationsParams{
+		ListCursorParams: sentry.ListCursorParams{},
+		ProviderKey:      providerKey,
+	}
+
+	for {
+		integrations, apiResp, err := r.client.OrganizationIntegrations.List(ctx, organization, params)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read organization integrations: %w", err)
+		}
+
+		for _, integration := range integrations {
+			if integration.Name == name {
+				return integration, nil
+			}
+		}
+
+		if apiResp.Cursor == "" {
+			break
+		}
+		params.ListCursorParams.Cursor = apiResp.Cursor
+	}
+
+	return nil, fmt.Errorf("no organization integration found for provider %q named %q; it must already be installed through Sentry's OAuth flow before Terraform can adopt it", providerKey, name)
+}
+
+func (r *organizationIntegrationInstallationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data organizationIntegrationInstallationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := r.findIntegration(ctx, data.Organization.ValueString(), data.ProviderKey.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(integration.ID)
+	data.IntegrationId = types.StringValue(integration.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationIntegrationInstallationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationIntegrationInstallationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &sentry.ListOrganizationIntegrationsParams{
+		ListCursorParams: sentry.ListCursorParams{},
+		ProviderKey:      data.ProviderKey.ValueString(),
+	}
+
+	var found *sentry.OrganizationIntegration
+	for {
+		integrations, apiResp, err := r.client.OrganizationIntegrations.List(ctx, data.Organization.ValueString(), params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization integrations, got error: %s", err))
+			return
+		}
+
+		for _, integration := range integrations {
+			if integration.ID == data.Id.ValueString() {
+				found = integration
+				break
+			}
+		}
+
+		if found != nil || apiResp.Cursor == "" {
+			break
+		}
+		params.ListCursorParams.Cursor = apiResp.Cursor
+	}
+
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.IntegrationId = types.StringValue(found.ID)
+	data.Name = types.StringValue(found.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationIntegrationInstallationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute except reset_config_on_destroy requires
+	// replacement, and that one only affects Delete, so there is nothing to
+	// apply remotely; the plan is copied into state as is.
+	var data organizationIntegrationInstallationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationIntegrationInstallationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data organizationIntegrationInstallationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no uninstall endpoint available through go-sentry for
+	// OAuth-based providers; clearing the config is the closest approximation
+	// available. Only do so when explicitly opted into, since this
+	// integration_id is often shared with one or more
+	// sentry_organization_integration_configuration resources, and blanking
+	// the whole remote config would clobber keys those resources own.
+	if !data.ResetConfigOnDestroy.ValueBool() {
+		return
+	}
+
+	if _, err := r.client.OrganizationIntegrations.UpdateConfig(ctx, data.Organization.ValueString(), data.Id.ValueString(), map[string]interface{}{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear organization integration config, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+
+	"github.com/teamsnap/terraform-provider-sentry/internal/provider"
+	"github.com/teamsnap/terraform-provider-sentry/sentry"
+)
+
+// version is overridden via -ldflags at release build time. It is passed
+// through to both provider servers so `sentry_*` data sources can surface it
+// in the user agent.
+//
+// This only mixes the two provider servers together; it does not by itself
+// move any resource from sentry.Provider to provider.New. tf6muxserver.NewMuxServer
+// errors at startup if both servers register a resource or data source under
+// the same type name, so a resource can only be added to provider.New once its
+// sentry.Provider counterpart (and ResourcesMap entry) is removed in the same
+// change — see the organization_integration_configuration resource for an
+// example. Every other `sentry_*` resource still lives on the SDKv2 side and
+// is unaffected until it's migrated over the same way, one resource at a
+// time, in its own follow-up change.
+var version = "dev"
+
+func main() {
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(
+		ctx,
+		func() tfprotov5.ProviderServer {
+			return sentry.Provider(version).GRPCProvider()
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(provider.New(version)()),
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	err = tf6server.Serve(
+		"registry.terraform.io/teamsnap/sentry",
+		muxServer.ProviderServer,
+		serveOpts...,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}